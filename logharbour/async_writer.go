@@ -0,0 +1,219 @@
+package logharbour
+
+import (
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// OverflowPolicy controls what AsyncWriter does with a write when its buffer
+// is full.
+type OverflowPolicy int
+
+const (
+	// DropNewest discards the incoming write when the buffer is full, handing
+	// it to OnDrop if set. It's the default.
+	DropNewest OverflowPolicy = iota
+	// DropOldest discards the oldest buffered write to make room for the
+	// incoming one, handing the discarded write to OnDrop if set.
+	DropOldest
+	// Block makes Write wait until there's room in the buffer, exerting
+	// backpressure on the caller instead of dropping anything.
+	Block
+)
+
+// AsyncWriterOpts configures an AsyncWriter.
+type AsyncWriterOpts struct {
+	// BufferSize bounds the number of pending writes queued for the background
+	// flusher before Overflow kicks in.
+	BufferSize int
+	// FlushInterval is how often buffered writes are flushed even if BufferSize
+	// hasn't been reached.
+	FlushInterval time.Duration
+	// Overflow selects what happens to a write when the buffer is full.
+	// Defaults to DropNewest.
+	Overflow OverflowPolicy
+	// OnDrop, if set, receives writes discarded by DropNewest or DropOldest
+	// because the buffer was full. It can point at a FallbackWriter's fallback,
+	// for example.
+	OnDrop func([]byte)
+}
+
+// AsyncWriterStats reports an AsyncWriter's cumulative counters, so operators
+// can alarm on drop rate.
+type AsyncWriterStats struct {
+	Enqueued int64
+	Written  int64
+	Dropped  int64
+}
+
+// AsyncWriter wraps an io.Writer so that callers don't block on the underlying
+// sink: writes are queued into a bounded channel, and a background goroutine
+// drains the queue, coalescing multiple buffered lines into a single Write call
+// to reduce syscall overhead on file/network sinks. It's meant for high-throughput
+// services where many goroutines each hold their own *Logger writing concurrently.
+type AsyncWriter struct {
+	inner io.Writer
+	opts  AsyncWriterOpts
+
+	queue  chan []byte
+	flush  chan chan struct{}
+	done   chan struct{}
+	wg     sync.WaitGroup
+	closed sync.Once
+
+	enqueued int64
+	written  int64
+	dropped  int64
+}
+
+// NewAsyncWriter creates an AsyncWriter wrapping inner, and starts its background
+// flush goroutine.
+func NewAsyncWriter(inner io.Writer, opts AsyncWriterOpts) *AsyncWriter {
+	if opts.BufferSize <= 0 {
+		opts.BufferSize = 1024
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = time.Second
+	}
+
+	aw := &AsyncWriter{
+		inner: inner,
+		opts:  opts,
+		queue: make(chan []byte, opts.BufferSize),
+		flush: make(chan chan struct{}),
+		done:  make(chan struct{}),
+	}
+	aw.wg.Add(1)
+	go aw.run()
+	return aw
+}
+
+// Write queues p for asynchronous delivery to the underlying writer. It never
+// blocks on the underlying writer's latency. What happens when the buffer is
+// full is governed by opts.Overflow: Block waits for room, DropOldest evicts
+// the oldest buffered write, and DropNewest (the default) discards p itself.
+// Write always reports len(p) written and a nil error, since delivery happens
+// asynchronously.
+func (aw *AsyncWriter) Write(p []byte) (int, error) {
+	msg := make([]byte, len(p))
+	copy(msg, p)
+
+	switch aw.opts.Overflow {
+	case Block:
+		select {
+		case aw.queue <- msg:
+			atomic.AddInt64(&aw.enqueued, 1)
+		case <-aw.done:
+		}
+	case DropOldest:
+		for {
+			select {
+			case aw.queue <- msg:
+				atomic.AddInt64(&aw.enqueued, 1)
+				return len(p), nil
+			default:
+			}
+			select {
+			case dropped := <-aw.queue:
+				atomic.AddInt64(&aw.dropped, 1)
+				if aw.opts.OnDrop != nil {
+					aw.opts.OnDrop(dropped)
+				}
+			default:
+			}
+		}
+	default: // DropNewest
+		select {
+		case aw.queue <- msg:
+			atomic.AddInt64(&aw.enqueued, 1)
+		default:
+			atomic.AddInt64(&aw.dropped, 1)
+			if aw.opts.OnDrop != nil {
+				aw.opts.OnDrop(msg)
+			}
+		}
+	}
+	return len(p), nil
+}
+
+// Stats returns a snapshot of the AsyncWriter's cumulative counters.
+func (aw *AsyncWriter) Stats() AsyncWriterStats {
+	return AsyncWriterStats{
+		Enqueued: atomic.LoadInt64(&aw.enqueued),
+		Written:  atomic.LoadInt64(&aw.written),
+		Dropped:  atomic.LoadInt64(&aw.dropped),
+	}
+}
+
+// run drains the queue, coalescing pending messages into a single underlying
+// Write call, on every periodic tick or whenever Flush is requested.
+func (aw *AsyncWriter) run() {
+	defer aw.wg.Done()
+
+	ticker := time.NewTicker(aw.opts.FlushInterval)
+	defer ticker.Stop()
+
+	var pending [][]byte
+	drainQueue := func() {
+		for {
+			select {
+			case msg := <-aw.queue:
+				pending = append(pending, msg)
+			default:
+				return
+			}
+		}
+	}
+	flushPending := func() {
+		drainQueue()
+		if len(pending) == 0 {
+			return
+		}
+		var batch []byte
+		for _, msg := range pending {
+			batch = append(batch, msg...)
+		}
+		if _, err := aw.inner.Write(batch); err == nil {
+			atomic.AddInt64(&aw.written, int64(len(pending)))
+		}
+		pending = pending[:0]
+	}
+
+	for {
+		select {
+		case msg := <-aw.queue:
+			pending = append(pending, msg)
+		case <-ticker.C:
+			flushPending()
+		case ack := <-aw.flush:
+			flushPending()
+			close(ack)
+		case <-aw.done:
+			flushPending()
+			return
+		}
+	}
+}
+
+// Flush blocks until all currently queued writes have been delivered to the
+// underlying writer.
+func (aw *AsyncWriter) Flush() {
+	ack := make(chan struct{})
+	select {
+	case aw.flush <- ack:
+		<-ack
+	case <-aw.done:
+	}
+}
+
+// Close flushes any pending writes and stops the background goroutine. It's
+// safe to call Close more than once.
+func (aw *AsyncWriter) Close() error {
+	aw.closed.Do(func() {
+		close(aw.done)
+	})
+	aw.wg.Wait()
+	return nil
+}
@@ -0,0 +1,89 @@
+package logharbour
+
+import (
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+)
+
+// ReopenableFileWriter wraps an *os.File opened for append, exposing Reopen so
+// external log-rotation tools (logrotate, etc.) can move the file out from under
+// the process and have it pick up a fresh file at the same path on signal.
+type ReopenableFileWriter struct {
+	path string
+
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewReopenableFileWriter opens path for appending, creating it if necessary.
+func NewReopenableFileWriter(path string) (*ReopenableFileWriter, error) {
+	f, err := openAppend(path)
+	if err != nil {
+		return nil, err
+	}
+	return &ReopenableFileWriter{path: path, file: f}, nil
+}
+
+// openAppend opens path with the flags expected of a log file: append-only,
+// created if missing, never truncated.
+func openAppend(path string) (*os.File, error) {
+	return os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+}
+
+// Write writes p to the underlying file.
+func (w *ReopenableFileWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.file.Write(p)
+}
+
+// Reopen atomically closes the current file and reopens the same path. Callers
+// typically invoke this after a log-rotation tool has renamed the path out from
+// under the process, so that subsequent writes land in a fresh file.
+func (w *ReopenableFileWriter) Reopen() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	newFile, err := openAppend(w.path)
+	if err != nil {
+		return err
+	}
+	oldFile := w.file
+	w.file = newFile
+	return oldFile.Close()
+}
+
+// Close closes the underlying file.
+func (w *ReopenableFileWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.file.Close()
+}
+
+// InstallSIGHUPReopen spawns a goroutine that calls w.Reopen whenever the process
+// receives SIGHUP, and returns a stop func to tear down the signal handler. A
+// failed Reopen leaves w writing to its previous file descriptor; composed with
+// a FallbackWriter, subsequent write failures degrade to the fallback sink.
+func InstallSIGHUPReopen(w *ReopenableFileWriter) (stop func()) {
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGHUP)
+	done := make(chan struct{})
+
+	go func() {
+		for {
+			select {
+			case <-sig:
+				w.Reopen()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		signal.Stop(sig)
+		close(done)
+	}
+}
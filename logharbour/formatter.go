@@ -0,0 +1,190 @@
+package logharbour
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Formatter renders a LogEntry to bytes for writing. Ship implementations are
+// JSONFormatter (the original behavior), LogfmtFormatter, and TextFormatter.
+type Formatter interface {
+	Format(entry LogEntry) ([]byte, error)
+}
+
+// JSONFormatter renders a LogEntry as a single line of JSON, matching the
+// logger's original behavior.
+type JSONFormatter struct{}
+
+// Format marshals entry as JSON, newline-terminated.
+func (JSONFormatter) Format(entry LogEntry) ([]byte, error) {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+// LogfmtFormatter renders a LogEntry as logfmt key=value pairs, the convention
+// used by go-kit/log: values containing whitespace, quotes, or newlines are
+// quoted and escaped.
+type LogfmtFormatter struct{}
+
+// Format renders entry as a single logfmt line.
+func (LogfmtFormatter) Format(entry LogEntry) ([]byte, error) {
+	var buf bytes.Buffer
+	writeLogfmtPair(&buf, "app", entry.AppName)
+	writeLogfmtPair(&buf, "type", entry.Type.string())
+	writeLogfmtPair(&buf, "priority", entry.Priority.string())
+	writeLogfmtPair(&buf, "time", entry.When.Format(timestampFormat))
+	if entry.Module != "" {
+		writeLogfmtPair(&buf, "module", entry.Module)
+	}
+	if entry.Who != "" {
+		writeLogfmtPair(&buf, "who", entry.Who)
+	}
+	writeLogfmtPair(&buf, "msg", entry.Message)
+	for _, kv := range flattenData(entry.Data) {
+		writeLogfmtPair(&buf, kv.key, kv.value)
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+// TextFormatter renders a LogEntry as a human-readable line:
+// "TIMESTAMP [LEVEL] app=... msg=\"...\"", optionally colored by priority.
+type TextFormatter struct {
+	// Color enables ANSI color codes per priority level.
+	Color bool
+}
+
+const timestampFormat = "2006-01-02T15:04:05.000Z07:00"
+
+// Format renders entry as a single human-readable line.
+func (f TextFormatter) Format(entry LogEntry) ([]byte, error) {
+	level := entry.Priority.string()
+	if f.Color {
+		level = colorize(entry.Priority, level)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%s [%s] app=%s", entry.When.Format(timestampFormat), level, entry.AppName)
+	if entry.Module != "" {
+		fmt.Fprintf(&buf, " module=%s", entry.Module)
+	}
+	fmt.Fprintf(&buf, " msg=%q", entry.Message)
+	for _, kv := range flattenData(entry.Data) {
+		fmt.Fprintf(&buf, " %s=%s", kv.key, quoteLogfmtValue(kv.value))
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+// ansi color codes per priority, per the TextFormatter convention: Debug=white,
+// Info=blue, Warn=yellow, Err=red, Crit=magenta, Sec=red-bold.
+func colorize(p LogPriority, s string) string {
+	const reset = "\033[0m"
+	var code string
+	switch p {
+	case Debug2, Debug1, Debug0:
+		code = "\033[37m" // white
+	case Info:
+		code = "\033[34m" // blue
+	case Warn:
+		code = "\033[33m" // yellow
+	case Err:
+		code = "\033[31m" // red
+	case Crit:
+		code = "\033[35m" // magenta
+	case Sec:
+		code = "\033[1;31m" // red-bold
+	default:
+		return s
+	}
+	return code + s + reset
+}
+
+type logfmtPair struct {
+	key   string
+	value string
+}
+
+// flattenData serializes the exported fields of a struct (or map) payload into
+// flat key/value pairs suitable for logfmt/text output. Non-struct, non-map
+// payloads are rendered under the key "data".
+func flattenData(data any) []logfmtPair {
+	if data == nil {
+		return nil
+	}
+
+	v := reflect.ValueOf(data)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	var pairs []logfmtPair
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" { // unexported
+				continue
+			}
+			pairs = append(pairs, logfmtPair{key: fieldKey(field), value: fmt.Sprintf("%v", v.Field(i).Interface())})
+		}
+	case reflect.Map:
+		mapKeys := v.MapKeys()
+		byKey := make(map[string]reflect.Value, len(mapKeys))
+		keys := make([]string, 0, len(mapKeys))
+		for _, k := range mapKeys {
+			key := fmt.Sprintf("%v", k.Interface())
+			byKey[key] = k
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			pairs = append(pairs, logfmtPair{key: k, value: fmt.Sprintf("%v", v.MapIndex(byKey[k]).Interface())})
+		}
+	default:
+		pairs = append(pairs, logfmtPair{key: "data", value: fmt.Sprintf("%v", data)})
+	}
+	return pairs
+}
+
+// fieldKey returns the logfmt key for a struct field, preferring its json tag
+// name if present.
+func fieldKey(field reflect.StructField) string {
+	if tag := field.Tag.Get("json"); tag != "" {
+		name := strings.Split(tag, ",")[0]
+		if name != "" && name != "-" {
+			return name
+		}
+	}
+	return field.Name
+}
+
+func writeLogfmtPair(buf *bytes.Buffer, key, value string) {
+	if buf.Len() > 0 {
+		buf.WriteByte(' ')
+	}
+	buf.WriteString(key)
+	buf.WriteByte('=')
+	buf.WriteString(quoteLogfmtValue(value))
+}
+
+// quoteLogfmtValue quotes value per the logfmt convention if it contains
+// whitespace, quotes, or newlines.
+func quoteLogfmtValue(value string) string {
+	if strings.ContainsAny(value, " \t\"\n=") {
+		return strconv.Quote(value)
+	}
+	return value
+}
@@ -0,0 +1,129 @@
+package logharbour
+
+import "sync"
+
+// Hook lets callers observe log entries as they're emitted, for side effects
+// like metrics, alerting, or aggregation, without wrapping the Logger's writer.
+// Fire is called synchronously after validation but before the entry is written,
+// for every hook whose Levels() includes the entry's priority.
+type Hook interface {
+	// Levels returns the priorities this hook wants to be notified about.
+	Levels() []LogPriority
+	// Fire is invoked with the log entry. A returned error is not fatal to the
+	// log call; it's the hook implementation's responsibility to handle its own errors.
+	Fire(entry LogEntry) error
+}
+
+// AddHook registers a hook with the Logger. Hooks are invoked in the order they were added.
+func (l *Logger) AddHook(hook Hook) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.hooks = append(l.hooks, hook)
+}
+
+// RemoveHook removes a previously registered hook. It's a no-op if the hook was never added.
+func (l *Logger) RemoveHook(hook Hook) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for i, h := range l.hooks {
+		if h == hook {
+			l.hooks = append(l.hooks[:i], l.hooks[i+1:]...)
+			return
+		}
+	}
+}
+
+// fireHooks invokes every registered hook whose Levels() match the entry's priority.
+func (l *Logger) fireHooks(entry LogEntry) {
+	for _, h := range l.hooks {
+		for _, p := range h.Levels() {
+			if p == entry.Priority {
+				h.Fire(entry)
+				break
+			}
+		}
+	}
+}
+
+// CounterHook counts log entries per priority, type, and module. It's useful for
+// exposing lightweight metrics without standing up a full metrics pipeline.
+type CounterHook struct {
+	mu      sync.Mutex
+	counts  map[string]int64
+	levels  []LogPriority
+}
+
+// NewCounterHook creates a CounterHook that fires for the given priorities.
+// If no priorities are given, it fires for all of them.
+func NewCounterHook(levels ...LogPriority) *CounterHook {
+	if len(levels) == 0 {
+		levels = []LogPriority{Debug2, Debug1, Debug0, Info, Warn, Err, Crit, Sec}
+	}
+	return &CounterHook{
+		counts: make(map[string]int64),
+		levels: levels,
+	}
+}
+
+// Levels returns the priorities this hook counts.
+func (h *CounterHook) Levels() []LogPriority {
+	return h.levels
+}
+
+// Fire increments the counter for the entry's priority, type, and module.
+func (h *CounterHook) Fire(entry LogEntry) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.counts[counterKey(entry.Priority, entry.Type, entry.Module)]++
+	return nil
+}
+
+// Snapshot returns a copy of the current counts, keyed by "priority|type|module".
+func (h *CounterHook) Snapshot() map[string]int64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	snapshot := make(map[string]int64, len(h.counts))
+	for k, v := range h.counts {
+		snapshot[k] = v
+	}
+	return snapshot
+}
+
+func counterKey(p LogPriority, t LogType, module string) string {
+	return p.string() + "|" + t.string() + "|" + module
+}
+
+// PanicOnWarningHook panics when a log entry at Warn or above is fired. It's meant
+// for tests and CI, where any warning-or-worse log should fail the run loudly.
+type PanicOnWarningHook struct{}
+
+// Levels returns the priorities PanicOnWarningHook fires for: Warn and everything more severe.
+func (PanicOnWarningHook) Levels() []LogPriority {
+	return []LogPriority{Warn, Err, Crit, Sec}
+}
+
+// Fire panics with the entry's message and priority.
+func (PanicOnWarningHook) Fire(entry LogEntry) error {
+	panic("logharbour: " + entry.Priority.string() + ": " + entry.Message)
+}
+
+// CallbackHook adapts a plain func into a Hook, for one-off use without defining a new type.
+type CallbackHook struct {
+	levels []LogPriority
+	fn     func(LogEntry) error
+}
+
+// NewCallbackHook wraps fn as a Hook that fires for the given priorities.
+func NewCallbackHook(fn func(LogEntry) error, levels ...LogPriority) *CallbackHook {
+	return &CallbackHook{levels: levels, fn: fn}
+}
+
+// Levels returns the priorities this hook fires for.
+func (h *CallbackHook) Levels() []LogPriority {
+	return h.levels
+}
+
+// Fire invokes the wrapped func.
+func (h *CallbackHook) Fire(entry LogEntry) error {
+	return h.fn(entry)
+}
@@ -1,7 +1,6 @@
 package logharbour
 
 import (
-	"encoding/json"
 	"io"
 	"os"
 	"runtime"
@@ -31,14 +30,18 @@ type Logger struct {
 	system         string              // System where the application is running.
 	module         string              // Module or subsystem within the application.
 	priority       LogPriority         // Priority level of the log messages.
+	priorityMask   LogPriorityMask     // If non-zero, takes precedence over priority for filtering.
 	who            string              // User or service performing the operation.
 	op             string              // Operation being performed.
 	whatClass      string              // Class of the object instance involved.
 	whatInstanceId string              // Unique ID of the object instance.
 	status         Status              // Status of the operation.
 	remoteIP       string              // IP address of the remote endpoint.
+	correlationID  string              // ID correlating this operation with related operations elsewhere.
 	writer         io.Writer           // Writer interface for log entries.
+	formatter      Formatter           // Renders entries to bytes; defaults to JSONFormatter if nil.
 	validator      *validator.Validate // Validator for log entries.
+	hooks          []Hook              // Hooks invoked for matching log entries.
 	mu             sync.Mutex          // Mutex for thread-safe operations.
 }
 
@@ -49,17 +52,29 @@ func (l *Logger) clone() *Logger {
 		system:         l.system,
 		module:         l.module,
 		priority:       l.priority,
+		priorityMask:   l.priorityMask,
 		who:            l.who,
 		op:             l.op,
 		whatClass:      l.whatClass,
 		whatInstanceId: l.whatInstanceId,
 		status:         l.status,
 		remoteIP:       l.remoteIP,
+		correlationID:  l.correlationID,
 		writer:         l.writer,
+		formatter:      l.formatter,
 		validator:      l.validator,
+		hooks:          append([]Hook(nil), l.hooks...),
 	}
 }
 
+// newValidator creates a validator.Validate with logharbour's struct-level
+// validations registered, such as requiring at least one change on ChangeInfo.
+func newValidator() *validator.Validate {
+	v := validator.New()
+	v.RegisterStructValidation(validateChangeInfo, ChangeInfo{})
+	return v
+}
+
 // NewLogger creates a new Logger with the specified application name and writer.
 // We recommend using NewLoggerWithFallback instead of this method.
 func NewLogger(appName string, writer io.Writer) *Logger {
@@ -67,7 +82,7 @@ func NewLogger(appName string, writer io.Writer) *Logger {
 		appName:   appName,
 		system:    GetSystemName(),
 		writer:    writer,
-		validator: validator.New(),
+		validator: newValidator(),
 		priority:  DefaultPriority,
 	}
 }
@@ -79,7 +94,7 @@ func NewLoggerWithFallback(appName string, fallbackWriter *FallbackWriter) *Logg
 		appName:   appName,
 		system:    GetSystemName(),
 		writer:    fallbackWriter,
-		validator: validator.New(),
+		validator: newValidator(),
 		priority:  DefaultPriority,
 	}
 }
@@ -140,6 +155,32 @@ func (l *Logger) WithRemoteIP(remoteIP string) *Logger {
 	return newLogger
 }
 
+// WithCorrelationID returns a new Logger with the 'correlationID' field set to
+// the specified value, for correlating this operation with related operations
+// logged elsewhere (e.g. by another service handling the same request).
+func (l *Logger) WithCorrelationID(correlationID string) *Logger {
+	newLogger := l.clone()
+	newLogger.correlationID = correlationID
+	return newLogger
+}
+
+// WithPriorityMask returns a new Logger filtering log entries by the given mask instead
+// of the threshold set via WithPriority. A non-zero mask takes precedence over the
+// threshold; pass PriorityNone to fall back to threshold-based filtering.
+func (l *Logger) WithPriorityMask(mask LogPriorityMask) *Logger {
+	newLogger := l.clone()
+	newLogger.priorityMask = mask
+	return newLogger
+}
+
+// WithFormatter returns a new Logger that renders entries using the given
+// Formatter instead of the default JSONFormatter.
+func (l *Logger) WithFormatter(formatter Formatter) *Logger {
+	newLogger := l.clone()
+	newLogger.formatter = formatter
+	return newLogger
+}
+
 // log writes a log entry. It locks the Logger's mutex to prevent concurrent write operations.
 func (l *Logger) log(entry LogEntry) error {
 	l.mu.Lock()
@@ -149,29 +190,41 @@ func (l *Logger) log(entry LogEntry) error {
 	if !l.shouldLog(entry.Priority) {
 		return nil
 	}
+	formatter := l.formatter
+	if formatter == nil {
+		formatter = JSONFormatter{}
+	}
 	if err := l.validator.Struct(entry); err != nil {
 		// Check if the writer is a FallbackWriter
 		if fw, ok := l.writer.(*FallbackWriter); ok {
 			// Write to the fallback writer if validation fails
-			return formatAndWriteEntry(fw.fallback, entry)
+			return formatAndWriteEntry(fw.fallback, formatter, entry)
 		}
 		return err
 	}
-	return formatAndWriteEntry(l.writer, entry)
+	l.fireHooks(entry)
+	if ew, ok := l.writer.(EntryWriter); ok {
+		return ew.WriteEntry(entry)
+	}
+	return formatAndWriteEntry(l.writer, formatter, entry)
 }
 
 // shouldLog determines whether a log entry should be written based on its priority.
+// If a priority mask has been set via WithPriorityMask, it takes precedence over the
+// threshold comparison, allowing an arbitrary set of priorities to be enabled.
 func (l *Logger) shouldLog(p LogPriority) bool {
+	if l.priorityMask != PriorityNone {
+		return l.priorityMask&p.bit() != 0
+	}
 	return p >= l.priority
 }
 
-// formatAndWriteEntry formats a log entry as JSON and writes it to the Logger's writer.
-func formatAndWriteEntry(writer io.Writer, entry LogEntry) error {
-	formattedEntry, err := json.Marshal(entry)
+// formatAndWriteEntry renders a log entry with formatter and writes it to writer.
+func formatAndWriteEntry(writer io.Writer, formatter Formatter, entry LogEntry) error {
+	formattedEntry, err := formatter.Format(entry)
 	if err != nil {
 		return err
 	}
-	formattedEntry = append(formattedEntry, '\n')
 	_, writeErr := writer.Write(formattedEntry)
 	return writeErr
 }
@@ -190,6 +243,7 @@ func (l *Logger) newLogEntry(message string, data any) LogEntry {
 		WhatInstanceId: l.whatInstanceId,
 		Status:         l.status,
 		RemoteIP:       l.remoteIP,
+		CorrelationID:  l.correlationID,
 		Message:        message,
 		Data:           data,
 	}
@@ -198,14 +252,14 @@ func (l *Logger) newLogEntry(message string, data any) LogEntry {
 // LogDataChange logs a data change event.
 func (l *Logger) LogDataChange(message string, data ChangeInfo) error {
 	entry := l.newLogEntry(message, data)
-	entry.Type = LogTypeChange
+	entry.Type = Change
 	return l.log(entry)
 }
 
 // LogActivity logs an activity event.
 func (l *Logger) LogActivity(message string, data ActivityInfo) error {
 	entry := l.newLogEntry(message, data)
-	entry.Type = LogTypeActivity
+	entry.Type = Activity
 	return l.log(entry)
 }
 
@@ -216,7 +270,7 @@ func (l *Logger) LogDebug(message string, data DebugInfo) error {
 	data.Runtime = runtime.Version()
 
 	entry := l.newLogEntry(message, data)
-	entry.Type = LogTypeDebug
+	entry.Type = Debug
 	return l.log(entry)
 }
 
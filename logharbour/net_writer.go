@@ -0,0 +1,286 @@
+package logharbour
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"time"
+)
+
+// ErrQueueFull is returned by NetWriter.Write when BlockOnFull is false and the
+// backpressure queue has no room left.
+var ErrQueueFull = errors.New("logharbour: net writer queue full")
+
+// NetWriterOpts configures a NetWriter.
+type NetWriterOpts struct {
+	// ReconnectOnMsg, if true, closes and reopens the connection after every message.
+	// Useful when writing to a load-balanced sink where a long-lived connection pins
+	// traffic to a single backend.
+	ReconnectOnMsg bool
+	// Reconnect, if true, redials and retries on write failure with exponential
+	// backoff (capped at 30s) instead of failing the write immediately.
+	Reconnect bool
+	// DialTimeout bounds how long a single dial attempt may take.
+	DialTimeout time.Duration
+	// WriteTimeout bounds how long a single write may take.
+	WriteTimeout time.Duration
+	// QueueSize bounds the number of in-flight Write calls, for backpressure.
+	QueueSize int
+	// BlockOnFull makes Write block for room in the queue instead of returning
+	// ErrQueueFull when it's full.
+	BlockOnFull bool
+	// ReplayBufferSize makes NetWriter buffer up to this many messages
+	// produced while disconnected instead of failing or blocking the caller.
+	// A background goroutine keeps retrying the connection and flushes
+	// buffered messages, oldest first, once it succeeds; past
+	// ReplayBufferSize the oldest buffered message is dropped to make room
+	// for the newest. Zero disables buffering.
+	ReplayBufferSize int
+}
+
+// NetWriter is an io.Writer that ships log lines to a remote collector over a
+// network connection (TCP or UDP), established lazily and redialed with
+// exponential backoff on failure. It's modeled on beego's connWriter. Pair it
+// with a FallbackWriter so a hard failure routes the entry to a fallback sink:
+//
+//	logharbour.NewFallbackWriter(logharbour.NewNetWriter("tcp", "logs.svc:5140", opts), os.Stderr)
+type NetWriter struct {
+	network string
+	addr    string
+	opts    NetWriterOpts
+
+	mu    sync.Mutex
+	conn  net.Conn
+	queue chan struct{} // semaphore bounding in-flight messages for backpressure
+
+	replay     *replayBuffer
+	replayDone chan struct{}
+}
+
+// replayBuffer is a bounded FIFO queue of messages produced while a NetWriter
+// is disconnected. Once full, the oldest buffered message is dropped to make
+// room for the newest.
+type replayBuffer struct {
+	mu       sync.Mutex
+	messages [][]byte
+	size     int
+}
+
+// push appends msg to the buffer, dropping the oldest buffered message first
+// if the buffer is already at capacity.
+func (rb *replayBuffer) push(msg []byte) {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+	if len(rb.messages) >= rb.size {
+		rb.messages = rb.messages[1:]
+	}
+	rb.messages = append(rb.messages, msg)
+}
+
+// drain removes and returns every currently buffered message, oldest first.
+func (rb *replayBuffer) drain() [][]byte {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+	messages := rb.messages
+	rb.messages = nil
+	return messages
+}
+
+// empty reports whether the buffer currently holds no messages.
+func (rb *replayBuffer) empty() bool {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+	return len(rb.messages) == 0
+}
+
+// NewNetWriter creates a NetWriter that dials addr over network ("tcp", "udp", ...)
+// on first write. The connection is kept alive and reused across writes unless
+// opts.ReconnectOnMsg is set.
+func NewNetWriter(network, addr string, opts NetWriterOpts) *NetWriter {
+	if opts.DialTimeout <= 0 {
+		opts.DialTimeout = 5 * time.Second
+	}
+	if opts.WriteTimeout <= 0 {
+		opts.WriteTimeout = 5 * time.Second
+	}
+	if opts.QueueSize <= 0 {
+		opts.QueueSize = 256
+	}
+
+	nw := &NetWriter{
+		network: network,
+		addr:    addr,
+		opts:    opts,
+		queue:   make(chan struct{}, opts.QueueSize),
+	}
+
+	if opts.ReplayBufferSize > 0 {
+		nw.replay = &replayBuffer{size: opts.ReplayBufferSize}
+		nw.replayDone = make(chan struct{})
+		go nw.replayLoop()
+	}
+
+	return nw
+}
+
+// Write sends p to the remote connection, dialing lazily on first use. On
+// net.Error it redials and retries with exponential backoff (capped at 30s)
+// when opts.Reconnect is set; otherwise the error is returned immediately so
+// a FallbackWriter can route the entry to its fallback sink. If
+// opts.ReplayBufferSize is set, a failure (dial or write) instead buffers p
+// for replayLoop to deliver once the connection comes back, and Write reports
+// success.
+func (nw *NetWriter) Write(p []byte) (int, error) {
+	if err := nw.acquire(); err != nil {
+		return 0, err
+	}
+	defer nw.release()
+
+	backoff := 100 * time.Millisecond
+	const maxBackoff = 30 * time.Second
+
+	for {
+		conn, err := nw.connection()
+		if err == nil {
+			conn.SetWriteDeadline(time.Now().Add(nw.opts.WriteTimeout))
+			n, werr := conn.Write(p)
+			if werr == nil {
+				if nw.opts.ReconnectOnMsg {
+					nw.resetConnection()
+				}
+				return n, nil
+			}
+			err = werr
+			nw.resetConnection()
+		}
+
+		if nw.replay != nil {
+			nw.replay.push(append([]byte(nil), p...))
+			return len(p), nil
+		}
+
+		if !nw.opts.Reconnect {
+			return 0, err
+		}
+		if _, ok := err.(net.Error); !ok {
+			return 0, err
+		}
+
+		time.Sleep(backoff)
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+// replayLoop retries the connection in the background and flushes buffered
+// messages, oldest first, as soon as it succeeds. It runs for the life of the
+// NetWriter whenever opts.ReplayBufferSize is set.
+func (nw *NetWriter) replayLoop() {
+	backoff := 100 * time.Millisecond
+	const maxBackoff = 30 * time.Second
+
+	ticker := time.NewTicker(backoff)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-nw.replayDone:
+			return
+		case <-ticker.C:
+		}
+
+		if nw.replay.empty() {
+			continue
+		}
+
+		conn, err := nw.connection()
+		if err != nil {
+			backoff *= 2
+			if backoff > maxBackoff {
+				backoff = maxBackoff
+			}
+			ticker.Reset(backoff)
+			continue
+		}
+
+		for _, msg := range nw.replay.drain() {
+			conn.SetWriteDeadline(time.Now().Add(nw.opts.WriteTimeout))
+			if _, werr := conn.Write(msg); werr != nil {
+				nw.resetConnection()
+				nw.replay.push(msg) // put it back; retry once reconnected
+				break
+			}
+		}
+		backoff = 100 * time.Millisecond
+		ticker.Reset(backoff)
+	}
+}
+
+// acquire reserves a slot in the backpressure queue, blocking or failing fast
+// depending on opts.BlockOnFull.
+func (nw *NetWriter) acquire() error {
+	if nw.opts.BlockOnFull {
+		nw.queue <- struct{}{}
+		return nil
+	}
+	select {
+	case nw.queue <- struct{}{}:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// release frees the slot reserved by acquire.
+func (nw *NetWriter) release() {
+	<-nw.queue
+}
+
+// connection returns the current connection, dialing a new one if none is open.
+func (nw *NetWriter) connection() (net.Conn, error) {
+	nw.mu.Lock()
+	defer nw.mu.Unlock()
+
+	if nw.conn != nil {
+		return nw.conn, nil
+	}
+	conn, err := net.DialTimeout(nw.network, nw.addr, nw.opts.DialTimeout)
+	if err != nil {
+		return nil, err
+	}
+	nw.conn = conn
+	return conn, nil
+}
+
+// resetConnection closes and clears the current connection so the next write redials.
+func (nw *NetWriter) resetConnection() {
+	nw.mu.Lock()
+	defer nw.mu.Unlock()
+	if nw.conn != nil {
+		nw.conn.Close()
+		nw.conn = nil
+	}
+}
+
+// Close closes the underlying connection, if any, and stops replayLoop if
+// opts.ReplayBufferSize was set. It's safe to call Close more than once.
+func (nw *NetWriter) Close() error {
+	if nw.replayDone != nil {
+		select {
+		case <-nw.replayDone:
+		default:
+			close(nw.replayDone)
+		}
+	}
+
+	nw.mu.Lock()
+	defer nw.mu.Unlock()
+	if nw.conn != nil {
+		err := nw.conn.Close()
+		nw.conn = nil
+		return err
+	}
+	return nil
+}
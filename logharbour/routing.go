@@ -0,0 +1,190 @@
+package logharbour
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// EntryWriter is implemented by sinks that want the structured LogEntry rather
+// than pre-formatted bytes, so routing decisions (by priority, by LogType) can
+// be made on the entry itself. A Logger writes through WriteEntry directly
+// when its writer implements EntryWriter, instead of formatting the entry
+// first. Writers that only implement io.Writer are still supported:
+// RoutingWriter falls back to formatting the entry with its configured
+// Formatter and calling Write.
+type EntryWriter interface {
+	WriteEntry(entry LogEntry) error
+}
+
+// Filter selects which log entries are routed to a destination.
+type Filter struct {
+	// MinPriority is the lowest priority that matches; zero value matches everything.
+	MinPriority LogPriority
+	// Types restricts matches to the given LogTypes; empty matches every LogType.
+	Types []LogType
+}
+
+// matches reports whether entry satisfies the filter.
+func (f Filter) matches(entry LogEntry) bool {
+	if entry.Priority < f.MinPriority {
+		return false
+	}
+	if len(f.Types) == 0 {
+		return true
+	}
+	for _, t := range f.Types {
+		if t == entry.Type {
+			return true
+		}
+	}
+	return false
+}
+
+// route pairs a destination with the filter that gates entries to it.
+type route struct {
+	writer    io.Writer
+	filter    Filter
+	formatter Formatter
+}
+
+// RoutingWriter dispatches each LogEntry to the registered destinations whose
+// Filter matches it — e.g. routing Sec and Crit entries to an alerting sink,
+// LogTypeChange entries to an audit file, and everything else to stdout.
+// RoutingWriter implements EntryWriter so a Logger threads the structured
+// entry through to it rather than pre-formatted bytes.
+type RoutingWriter struct {
+	mu     sync.Mutex
+	routes []route
+}
+
+// NewRoutingWriter creates an empty RoutingWriter; add destinations with Add.
+func NewRoutingWriter() *RoutingWriter {
+	return &RoutingWriter{}
+}
+
+// Add registers a destination writer for entries matching filter. If writer
+// implements EntryWriter, its WriteEntry is called directly; otherwise the
+// entry is formatted with formatter (or JSONFormatter if nil) and written via
+// io.Writer.
+func (r *RoutingWriter) Add(writer io.Writer, filter Filter, formatter Formatter) {
+	if formatter == nil {
+		formatter = JSONFormatter{}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.routes = append(r.routes, route{writer: writer, filter: filter, formatter: formatter})
+}
+
+// WriteEntry dispatches entry to every registered destination whose filter matches.
+// It returns the first error encountered, after attempting all matching destinations.
+func (r *RoutingWriter) WriteEntry(entry LogEntry) error {
+	r.mu.Lock()
+	routes := make([]route, len(r.routes))
+	copy(routes, r.routes)
+	r.mu.Unlock()
+
+	var firstErr error
+	for _, rt := range routes {
+		if !rt.filter.matches(entry) {
+			continue
+		}
+		var err error
+		if ew, ok := rt.writer.(EntryWriter); ok {
+			err = ew.WriteEntry(entry)
+		} else {
+			var formatted []byte
+			formatted, err = rt.formatter.Format(entry)
+			if err == nil {
+				_, err = rt.writer.Write(formatted)
+			}
+		}
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// Write implements io.Writer for legacy callers by JSON-decoding p back into a
+// LogEntry and routing it. Sinks that control their own writer should prefer
+// WriteEntry, since this path round-trips through JSON.
+func (r *RoutingWriter) Write(p []byte) (int, error) {
+	var entry LogEntry
+	if err := json.Unmarshal(p, &entry); err != nil {
+		return 0, err
+	}
+	if err := r.WriteEntry(entry); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// WebhookWriter POSTs each log entry as JSON to a URL, retrying on failure.
+// It implements EntryWriter so it can be registered directly with a RoutingWriter.
+type WebhookWriter struct {
+	URL        string
+	Headers    map[string]string
+	MaxRetries int
+	Client     *http.Client
+}
+
+// NewWebhookWriter creates a WebhookWriter posting to url with the given headers.
+func NewWebhookWriter(url string, headers map[string]string) *WebhookWriter {
+	return &WebhookWriter{
+		URL:        url,
+		Headers:    headers,
+		MaxRetries: 3,
+		Client:     &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// WriteEntry POSTs entry as JSON to the configured URL, retrying up to MaxRetries
+// times on failure or non-2xx response.
+func (w *WebhookWriter) WriteEntry(entry LogEntry) error {
+	body, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= w.MaxRetries; attempt++ {
+		req, err := http.NewRequest(http.MethodPost, w.URL, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		for k, v := range w.Headers {
+			req.Header.Set(k, v)
+		}
+
+		resp, err := w.Client.Do(req)
+		if err != nil {
+			lastErr = err
+		} else {
+			resp.Body.Close()
+			if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+				return nil
+			}
+			lastErr = fmt.Errorf("logharbour: webhook returned status %d", resp.StatusCode)
+		}
+		time.Sleep(time.Duration(attempt+1) * 200 * time.Millisecond)
+	}
+	return lastErr
+}
+
+// Write implements io.Writer for completeness, JSON-decoding p back into a LogEntry.
+func (w *WebhookWriter) Write(p []byte) (int, error) {
+	var entry LogEntry
+	if err := json.Unmarshal(p, &entry); err != nil {
+		return 0, err
+	}
+	if err := w.WriteEntry(entry); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
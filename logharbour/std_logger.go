@@ -0,0 +1,44 @@
+package logharbour
+
+import (
+	"bytes"
+	"log"
+)
+
+// stdLoggerAdapter is an io.Writer that parses lines written by a standard
+// library *log.Logger and dispatches them through a Logger's log method,
+// preserving the priority, type, and With*-configured context at the time
+// StdLogger was called.
+type stdLoggerAdapter struct {
+	logger   *Logger
+	priority LogPriority
+	logType  LogType
+}
+
+// Write parses p, which may contain multiple newline-terminated lines written
+// by the standard log.Logger, and emits one LogEntry per line.
+func (a *stdLoggerAdapter) Write(p []byte) (int, error) {
+	for _, line := range bytes.Split(bytes.TrimRight(p, "\n"), []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+		entry := a.logger.newLogEntry(string(line), nil)
+		entry.Type = a.logType
+		entry.Priority = a.priority
+		if err := a.logger.log(entry); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// StdLogger returns a standard library *log.Logger backed by l: every line it's
+// asked to print is wrapped in a LogEntry at the given priority and log type,
+// carrying l's current With*-configured context (app, module, who, etc.), and
+// dispatched through l's logging pipeline instead of escaping to os.Stderr.
+// This lets third-party libraries (net/http, database/sql, grpc) that accept a
+// *log.Logger have their output flow into the structured pipeline.
+func (l *Logger) StdLogger(priority LogPriority, logType LogType) *log.Logger {
+	adapter := &stdLoggerAdapter{logger: l, priority: priority, logType: logType}
+	return log.New(adapter, "", 0)
+}
@@ -5,6 +5,8 @@ import (
 	"io"
 	"sync"
 	"time"
+
+	"github.com/go-playground/validator/v10"
 )
 
 // logPriority defines the severity level of a log message.
@@ -33,6 +35,50 @@ const (
 	LogPriorityUnknown = "Unknown"
 )
 
+// LogPriorityMask is a bitmask over LogPriority values, letting a Logger enable an
+// arbitrary set of priorities (e.g. Sec|Err|Crit) instead of a single threshold.
+type LogPriorityMask uint
+
+const (
+	PriorityDebug2 LogPriorityMask = 1 << iota
+	PriorityDebug1
+	PriorityDebug0
+	PriorityInfo
+	PriorityWarn
+	PriorityErr
+	PriorityCrit
+	PrioritySec
+
+	// PriorityNone matches no priorities.
+	PriorityNone LogPriorityMask = 0
+	// PriorityAll matches every priority.
+	PriorityAll = PriorityDebug2 | PriorityDebug1 | PriorityDebug0 | PriorityInfo | PriorityWarn | PriorityErr | PriorityCrit | PrioritySec
+)
+
+// bit returns the LogPriorityMask bit corresponding to a LogPriority.
+func (lp LogPriority) bit() LogPriorityMask {
+	switch lp {
+	case Debug2:
+		return PriorityDebug2
+	case Debug1:
+		return PriorityDebug1
+	case Debug0:
+		return PriorityDebug0
+	case Info:
+		return PriorityInfo
+	case Warn:
+		return PriorityWarn
+	case Err:
+		return PriorityErr
+	case Crit:
+		return PriorityCrit
+	case Sec:
+		return PrioritySec
+	default:
+		return PriorityNone
+	}
+}
+
 // String returns the string representation of the logPriority.
 func (lp LogPriority) string() string {
 	switch lp {
@@ -63,6 +109,42 @@ func (lp LogPriority) MarshalJSON() ([]byte, error) {
 	return json.Marshal(lp.string())
 }
 
+// parseLogPriority returns the LogPriority corresponding to its string representation.
+func parseLogPriority(s string) LogPriority {
+	switch s {
+	case LogPriorityDebug2:
+		return Debug2
+	case LogPriorityDebug1:
+		return Debug1
+	case LogPriorityDebug0:
+		return Debug0
+	case LogPriorityInfo:
+		return Info
+	case LogPriorityWarn:
+		return Warn
+	case LogPriorityErr:
+		return Err
+	case LogPriorityCrit:
+		return Crit
+	case LogPrioritySec:
+		return Sec
+	default:
+		return 0
+	}
+}
+
+// UnmarshalJSON is the inverse of MarshalJSON, parsing the string representation
+// written by MarshalJSON back into a LogPriority. It's what lets a LogEntry
+// round-trip through JSON, e.g. via a RoutingWriter's legacy io.Writer path.
+func (lp *LogPriority) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*lp = parseLogPriority(s)
+	return nil
+}
+
 // LogType defines the category of a log message.
 type LogType int
 
@@ -102,6 +184,27 @@ func (lt LogType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(lt.string())
 }
 
+// UnmarshalJSON is the inverse of MarshalJSON, parsing the string representation
+// written by MarshalJSON back into a LogType. It's what lets a LogEntry
+// round-trip through JSON, e.g. via a RoutingWriter's legacy io.Writer path.
+func (lt *LogType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	switch s {
+	case LogTypeChange:
+		*lt = Change
+	case LogTypeActivity:
+		*lt = Activity
+	case LogTypeDebug:
+		*lt = Debug
+	default:
+		*lt = 0
+	}
+	return nil
+}
+
 type Status int
 
 const (
@@ -123,15 +226,57 @@ type LogEntry struct {
 	WhatInstanceId string      // Unique ID, name, or other "primary key" information of the object instance on which the operation was being attempted
 	Status         Status      // 0 or 1, indicating success (1) or failure (0), or some other binary representation
 	RemoteIP       string      // IP address of the caller from where the operation is being performed.
+	CorrelationID  string      // ID correlating this log entry with related operations logged elsewhere.
 	Message        string      // A descriptive message for the log entry.
 	Data           any         // The payload of the log entry, can be any type.
 }
 
+// ChangeDetail records a single field-level change: its name, the value it held
+// before, and the value it holds after.
+type ChangeDetail struct {
+	Field    string `json:"field"`
+	OldValue any    `json:"old_value"`
+	NewValue any    `json:"new_value"`
+}
+
 // ChangeInfo holds information about data changes such as creations, updates, or deletions.
+//
+// Changes carries the original map-based representation; ChangeDetails carries the
+// structured, builder-populated representation with per-field old/new values. Both
+// are marshaled when present, so existing callers that populate Changes directly
+// keep working unchanged.
 type ChangeInfo struct {
-	Entity    string         `json:"entity"`
-	Operation string         `json:"operation"`
-	Changes   map[string]any `json:"changes"`
+	Entity        string         `json:"entity" validate:"required"`
+	Operation     string         `json:"operation" validate:"required"`
+	Changes       map[string]any `json:"changes,omitempty"`
+	ChangeDetails []ChangeDetail `json:"change_details,omitempty"`
+}
+
+// NewChangeInfo creates a ChangeInfo for the given entity and operation, ready to
+// accumulate field-level changes via AddChange.
+func NewChangeInfo(entity, operation string) *ChangeInfo {
+	return &ChangeInfo{Entity: entity, Operation: operation}
+}
+
+// AddChange appends a field-level old/new value pair to the ChangeInfo and returns
+// it, so calls can be chained:
+//
+//	ci := NewChangeInfo("User", "Update").
+//		AddChange("email", "old@example.com", "new@example.com").
+//		AddChange("status", "pending", "active")
+func (ci *ChangeInfo) AddChange(field string, oldVal, newVal any) *ChangeInfo {
+	ci.ChangeDetails = append(ci.ChangeDetails, ChangeDetail{Field: field, OldValue: oldVal, NewValue: newVal})
+	return ci
+}
+
+// validateChangeInfo is a struct-level validator ensuring a ChangeInfo carries at
+// least one recorded change, via either the map or the builder-populated slice.
+// It's registered against the Logger's validator in NewLogger/NewLoggerWithFallback.
+func validateChangeInfo(sl validator.StructLevel) {
+	ci := sl.Current().Interface().(ChangeInfo)
+	if len(ci.Changes) == 0 && len(ci.ChangeDetails) == 0 {
+		sl.ReportError(ci.ChangeDetails, "ChangeDetails", "ChangeDetails", "min", "1")
+	}
 }
 
 // ActivityInfo holds information about system activities like web service calls or function executions.
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ssd532/logdock/logharbour"
+)
+
+// discardSlowWriter simulates a sink with nontrivial per-write latency, such as
+// a file or network writer under contention.
+type discardSlowWriter struct {
+	delay time.Duration
+}
+
+func (w *discardSlowWriter) Write(p []byte) (int, error) {
+	time.Sleep(w.delay)
+	return len(p), nil
+}
+
+// BenchmarkSyncWriteUnderContention measures writing directly to a slow sink
+// from many concurrent goroutines, each holding its own *Logger.
+func BenchmarkSyncWriteUnderContention(b *testing.B) {
+	writer := &discardSlowWriter{delay: 50 * time.Microsecond}
+
+	b.ResetTimer()
+	var wg sync.WaitGroup
+	for i := 0; i < b.N; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			logger := logharbour.NewLogger("BenchApp", writer)
+			logger.LogActivity("activity", map[string]any{"i": i})
+		}(i)
+	}
+	wg.Wait()
+}
+
+// BenchmarkAsyncWriteUnderContention measures the same workload with an
+// AsyncWriter in front of the slow sink, demonstrating the win from batching
+// writes instead of hitting the sink once per goroutine.
+func BenchmarkAsyncWriteUnderContention(b *testing.B) {
+	writer := &discardSlowWriter{delay: 50 * time.Microsecond}
+	aw := logharbour.NewAsyncWriter(writer, logharbour.AsyncWriterOpts{
+		BufferSize:    4096,
+		FlushInterval: 10 * time.Millisecond,
+	})
+	defer aw.Close()
+
+	b.ResetTimer()
+	var wg sync.WaitGroup
+	for i := 0; i < b.N; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			logger := logharbour.NewLogger("BenchApp", aw)
+			logger.LogActivity("activity", map[string]any{"i": i})
+		}(i)
+	}
+	wg.Wait()
+	aw.Flush()
+}
+
+var _ io.Writer = (*discardSlowWriter)(nil)
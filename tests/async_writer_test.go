@@ -0,0 +1,102 @@
+package main
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ssd532/logdock/logharbour"
+)
+
+// gateWriter blocks its first Write until release is closed, signalling
+// started first. It lets a test pin the AsyncWriter's flusher goroutine
+// mid-flush so the queue can be driven to a known full state deterministically,
+// instead of racing the flusher's normal drain speed.
+type gateWriter struct {
+	mu      sync.Mutex
+	dest    *bytes.Buffer
+	started chan struct{}
+	release chan struct{}
+	once    sync.Once
+}
+
+func (w *gateWriter) Write(p []byte) (int, error) {
+	w.once.Do(func() { close(w.started) })
+	<-w.release
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.dest.Write(p)
+}
+
+// TestAsyncWriterDropNewestCountsDrops verifies the default overflow policy:
+// once the buffer is full, new writes are dropped (handed to OnDrop) rather
+// than blocking, and Stats reflects the drop.
+func TestAsyncWriterDropNewestCountsDrops(t *testing.T) {
+	var dest bytes.Buffer
+	var mu sync.Mutex
+	var droppedCount int
+	writer := &gateWriter{dest: &dest, started: make(chan struct{}), release: make(chan struct{})}
+
+	aw := logharbour.NewAsyncWriter(writer, logharbour.AsyncWriterOpts{
+		BufferSize:    1,
+		FlushInterval: 5 * time.Millisecond,
+		OnDrop: func([]byte) {
+			mu.Lock()
+			defer mu.Unlock()
+			droppedCount++
+		},
+	})
+
+	aw.Write([]byte("a"))
+
+	select {
+	case <-writer.started:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for the flusher to start draining")
+	}
+
+	// The flusher is now blocked inside inner.Write, so it isn't draining the
+	// queue: these should fill the 1-slot buffer and then start dropping.
+	aw.Write([]byte("b"))
+	aw.Write([]byte("c"))
+
+	close(writer.release)
+	aw.Close()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if droppedCount == 0 {
+		t.Errorf("expected at least one drop once the buffer filled, got stats: %+v", aw.Stats())
+	}
+}
+
+// TestAsyncWriterBlockWaitsForRoom verifies that the Block overflow policy
+// makes Write wait for room instead of dropping.
+func TestAsyncWriterBlockWaitsForRoom(t *testing.T) {
+	var dest bytes.Buffer
+	aw := logharbour.NewAsyncWriter(&dest, logharbour.AsyncWriterOpts{
+		BufferSize:    1,
+		FlushInterval: time.Millisecond,
+		Overflow:      logharbour.Block,
+	})
+
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 5; i++ {
+			aw.Write([]byte("x"))
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Block writes never returned; expected the flusher to drain the buffer")
+	}
+	aw.Close()
+
+	if stats := aw.Stats(); stats.Dropped != 0 {
+		t.Errorf("expected no drops under the Block policy, got stats: %+v", stats)
+	}
+}
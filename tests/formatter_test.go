@@ -0,0 +1,73 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ssd532/logdock/logharbour"
+)
+
+func sampleEntry(data any) logharbour.LogEntry {
+	return logharbour.LogEntry{
+		AppName:  "TestApp",
+		Type:     logharbour.Activity,
+		Priority: logharbour.Info,
+		When:     time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Module:   "billing",
+		Who:      "alice",
+		Message:  "charged card",
+		Data:     data,
+	}
+}
+
+// TestLogfmtFormatterRendersKeyValuePairs checks that LogfmtFormatter emits the
+// fixed fields plus the flattened struct payload as logfmt key=value pairs.
+func TestLogfmtFormatterRendersKeyValuePairs(t *testing.T) {
+	entry := sampleEntry(struct {
+		UserID string `json:"user_id"`
+	}{UserID: "u-1"})
+
+	out, err := logharbour.LogfmtFormatter{}.Format(entry)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	line := string(out)
+	for _, want := range []string{"app=TestApp", "module=billing", "who=alice", `msg="charged card"`, "user_id=u-1"} {
+		if !strings.Contains(line, want) {
+			t.Errorf("expected output to contain %q, got: %s", want, line)
+		}
+	}
+}
+
+// TestFlattenDataNonStringMapKeys verifies that flattenData handles maps keyed
+// by a non-string type instead of panicking when looking up the rendered key.
+func TestFlattenDataNonStringMapKeys(t *testing.T) {
+	entry := sampleEntry(map[int]string{2: "two", 1: "one"})
+
+	out, err := logharbour.LogfmtFormatter{}.Format(entry)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	line := string(out)
+	if !strings.Contains(line, "1=one") || !strings.Contains(line, "2=two") {
+		t.Errorf("expected flattened map entries in output, got: %s", line)
+	}
+}
+
+// TestTextFormatterRendersHumanReadableLine checks TextFormatter's basic shape.
+func TestTextFormatterRendersHumanReadableLine(t *testing.T) {
+	entry := sampleEntry(nil)
+
+	out, err := logharbour.TextFormatter{}.Format(entry)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	line := string(out)
+	if !strings.Contains(line, "[Info]") || !strings.Contains(line, "app=TestApp") || !strings.Contains(line, `msg="charged card"`) {
+		t.Errorf("unexpected TextFormatter output: %s", line)
+	}
+}
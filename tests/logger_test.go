@@ -8,13 +8,6 @@ import (
 	"github.com/ssd532/logdock/logharbour"
 )
 
-type ValidatorFunc func(entry any) error
-
-// Ensure ValidatorFunc implements the logharbour.Validator interface by providing a Validate method.
-func (vf ValidatorFunc) Validate(entry any) error {
-	return vf(entry)
-}
-
 // mockWriter is a simple in-memory writer to capture log outputs for testing.
 type mockWriter struct {
 	bytes.Buffer
@@ -32,10 +25,8 @@ func TestPriorityLevelPrinting(t *testing.T) {
 	// Create a fallback writer that uses the mock writer for both primary and fallback outputs.
 	fallbackWriter := logharbour.NewFallbackWriter(output, output)
 
-	// Initialize the logger with a basic context and validator, and a test priority level.
-	logger := logharbour.NewLogger("TestApp", ValidatorFunc(func(entry any) error {
-		return nil
-	}), fallbackWriter)
+	// Initialize the logger with a test priority level.
+	logger := logharbour.NewLoggerWithFallback("TestApp", fallbackWriter).WithPriority(logharbour.Debug1)
 
 	// log a message at Debug1 level.
 	logger.LogDebug("Debug1 message", logharbour.DebugInfo{})
@@ -56,6 +47,31 @@ func TestPriorityLevelPrinting(t *testing.T) {
 	}
 }
 
+// TestWithChainSetsFieldsOnLogEntry verifies that chaining Logger's With*
+// methods, including WithCorrelationID, carries those values through to the
+// LogEntry that ultimately gets written.
+func TestWithChainSetsFieldsOnLogEntry(t *testing.T) {
+	output := new(mockWriter)
+	fallbackWriter := logharbour.NewFallbackWriter(output, output)
+
+	logger := logharbour.NewLoggerWithFallback("TestApp", fallbackWriter).
+		WithModule("billing").
+		WithWho("alice").
+		WithOp("ChargeCard").
+		WithStatus(logharbour.Success).
+		WithRemoteIP("127.0.0.1").
+		WithCorrelationID("req-123")
+
+	logger.LogActivity("card charged", map[string]any{"amount": 42})
+
+	outputStr := output.String()
+	for _, want := range []string{"billing", "alice", "ChargeCard", "127.0.0.1", "req-123"} {
+		if !bytes.Contains(output.Bytes(), []byte(want)) {
+			t.Errorf("expected logged entry to contain %q, got: %s", want, outputStr)
+		}
+	}
+}
+
 // mockFailingWriter is a writer that fails when attempting to write to it.
 type mockFailingWriter struct {
 	fail bool // Determines if the writer should fail.
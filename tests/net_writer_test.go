@@ -0,0 +1,189 @@
+package main
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ssd532/logdock/logharbour"
+)
+
+// TestNetWriterDeliversOverTCP verifies that NetWriter delivers a message to a
+// listening TCP server, dialing the connection lazily on first write.
+func TestNetWriterDeliversOverTCP(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start test listener: %v", err)
+	}
+	defer ln.Close()
+
+	received := make(chan []byte, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		buf := make([]byte, 1024)
+		n, err := conn.Read(buf)
+		if err != nil {
+			return
+		}
+		received <- buf[:n]
+	}()
+
+	nw := logharbour.NewNetWriter("tcp", ln.Addr().String(), logharbour.NetWriterOpts{})
+	defer nw.Close()
+
+	if _, err := nw.Write([]byte("hello")); err != nil {
+		t.Fatalf("unexpected error writing: %v", err)
+	}
+
+	select {
+	case msg := <-received:
+		if string(msg) != "hello" {
+			t.Errorf("expected server to receive %q, got %q", "hello", msg)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for server to receive message")
+	}
+}
+
+// TestNetWriterReconnectsAfterServerClose verifies that NetWriter redials and
+// retries when the server closes the connection mid-stream and Reconnect is enabled.
+//
+// The first connection is closed with SetLinger(0), which makes the kernel
+// send an RST instead of a FIN. A plain Close (FIN) often lets the client's
+// next Write succeed anyway, buffered by the kernel, without NetWriter ever
+// observing a failure or redialing — an RST is what actually surfaces as a
+// write error. A channel, not a sleep, synchronizes the client's second write
+// with the server having closed the first connection.
+func TestNetWriterReconnectsAfterServerClose(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start test listener: %v", err)
+	}
+	defer ln.Close()
+
+	closed := make(chan struct{})
+	received := make(chan []byte, 1)
+	go func() {
+		// First connection reads the first write, then force-closes with an
+		// RST so the client's next write is guaranteed to fail.
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		buf := make([]byte, 1024)
+		if _, err := conn.Read(buf); err != nil {
+			return
+		}
+		if tcpConn, ok := conn.(*net.TCPConn); ok {
+			tcpConn.SetLinger(0)
+		}
+		conn.Close()
+		close(closed)
+
+		// Second connection (after redial) reads the retried message.
+		conn, err = ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		n, err := conn.Read(buf)
+		if err != nil {
+			return
+		}
+		received <- buf[:n]
+	}()
+
+	nw := logharbour.NewNetWriter("tcp", ln.Addr().String(), logharbour.NetWriterOpts{
+		Reconnect: true,
+	})
+	defer nw.Close()
+
+	if _, err := nw.Write([]byte("first")); err != nil {
+		t.Fatalf("unexpected error writing: %v", err)
+	}
+
+	select {
+	case <-closed:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for server to close the first connection")
+	}
+
+	if _, err := nw.Write([]byte("retry me")); err != nil {
+		t.Fatalf("unexpected error writing: %v", err)
+	}
+
+	select {
+	case msg := <-received:
+		if string(msg) != "retry me" {
+			t.Errorf("expected server to receive %q, got %q", "retry me", msg)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for reconnect to succeed")
+	}
+}
+
+// TestNetWriterReplayBufferFlushesOnReconnect verifies that with
+// ReplayBufferSize set, messages produced while there's no listener yet are
+// buffered instead of lost, and get delivered once a listener comes up.
+func TestNetWriterReplayBufferFlushesOnReconnect(t *testing.T) {
+	// Reserve an address with no listener yet, so the first writes fail to dial.
+	probe, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to reserve an address: %v", err)
+	}
+	addr := probe.Addr().String()
+	probe.Close()
+
+	nw := logharbour.NewNetWriter("tcp", addr, logharbour.NetWriterOpts{
+		ReplayBufferSize: 8,
+	})
+	defer nw.Close()
+
+	if _, err := nw.Write([]byte("buffered 1")); err != nil {
+		t.Fatalf("expected Write to buffer rather than fail, got: %v", err)
+	}
+	if _, err := nw.Write([]byte("buffered 2")); err != nil {
+		t.Fatalf("expected Write to buffer rather than fail, got: %v", err)
+	}
+
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		t.Fatalf("failed to start listener on the reserved address: %v", err)
+	}
+	defer ln.Close()
+
+	received := make(chan string, 2)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		buf := make([]byte, 1024)
+		for i := 0; i < 2; i++ {
+			n, err := conn.Read(buf)
+			if err != nil {
+				return
+			}
+			received <- string(buf[:n])
+		}
+	}()
+
+	var got []string
+	for i := 0; i < 2; i++ {
+		select {
+		case msg := <-received:
+			got = append(got, msg)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for buffered messages to be replayed, got so far: %v", got)
+		}
+	}
+
+	if got[0] != "buffered 1" || got[1] != "buffered 2" {
+		t.Errorf("expected buffered messages replayed in order, got: %v", got)
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ssd532/logdock/logharbour"
+)
+
+// TestRoutingWriterDispatchesByFilter verifies that a RoutingWriter only
+// delivers entries to destinations whose Filter matches, and delivers to
+// every matching destination when more than one matches.
+func TestRoutingWriterDispatchesByFilter(t *testing.T) {
+	var changes, everything bytes.Buffer
+
+	r := logharbour.NewRoutingWriter()
+	r.Add(&changes, logharbour.Filter{Types: []logharbour.LogType{logharbour.Change}}, nil)
+	r.Add(&everything, logharbour.Filter{}, nil)
+
+	logger := logharbour.NewLogger("TestApp", r)
+	logger.LogActivity("user logged in", map[string]any{"user": "alice"})
+	logger.LogDataChange("user updated", logharbour.ChangeInfo{Entity: "User", Operation: "Update", Changes: map[string]any{"email": "new@x.com"}})
+
+	if changes.Len() == 0 {
+		t.Errorf("expected the change-only destination to receive the data-change entry")
+	}
+	if bytes.Contains(changes.Bytes(), []byte("user logged in")) {
+		t.Errorf("expected the change-only destination not to receive the activity entry")
+	}
+	if !bytes.Contains(everything.Bytes(), []byte("user logged in")) || !bytes.Contains(everything.Bytes(), []byte("user updated")) {
+		t.Errorf("expected the catch-all destination to receive both entries, got: %s", everything.String())
+	}
+}
+
+// TestRoutingWriterWriteRoundTripsThroughJSON verifies the legacy io.Writer
+// path, which JSON-decodes p back into a LogEntry before routing it. This
+// exercises LogPriority/LogType's UnmarshalJSON, which must be the exact
+// inverse of their MarshalJSON.
+func TestRoutingWriterWriteRoundTripsThroughJSON(t *testing.T) {
+	var dest bytes.Buffer
+
+	r := logharbour.NewRoutingWriter()
+	r.Add(&dest, logharbour.Filter{}, nil)
+
+	entry := logharbour.LogEntry{
+		AppName:  "TestApp",
+		Type:     logharbour.Activity,
+		Priority: logharbour.Info,
+		Message:  "round trip me",
+	}
+	formatted, err := logharbour.JSONFormatter{}.Format(entry)
+	if err != nil {
+		t.Fatalf("unexpected error formatting entry: %v", err)
+	}
+
+	if _, err := r.Write(formatted); err != nil {
+		t.Fatalf("unexpected error from RoutingWriter.Write: %v", err)
+	}
+	if !bytes.Contains(dest.Bytes(), []byte("round trip me")) {
+		t.Errorf("expected routed destination to receive the decoded entry, got: %s", dest.String())
+	}
+}